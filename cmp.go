@@ -29,6 +29,12 @@ func By[T any, R Ordered](f func(T) R) Comparator[T] {
 //
 // For floating-point types, a NaN is considered less than any non-NaN,
 // a NaN is considered equal to a NaN, and -0.0 is equal to 0.0.
+//
+// This means Natural[T]().Max diverges from the built-in max function on
+// NaN: built-in max propagates NaN, while a Comparator built on Natural
+// silently discards it as if it were the smallest value. Natural[T]().Min
+// returns the NaN, because it orders as the smallest value. Use [NaturalPropagateNaN] or [PropagateNaN] for built-in-compatible
+// NaN handling.
 func Natural[T Ordered]() Comparator[T] {
 	return cmp.Compare
 }
@@ -112,7 +118,7 @@ func (c Comparator[T]) Max(value T, values ...T) T {
 }
 
 func (c Comparator[T]) Min(value T, values ...T) T {
-	min := values[0]
+	min := value
 	for _, v := range values {
 		if c(v, min) < 0 {
 			min = v
@@ -0,0 +1,112 @@
+package cmp
+
+import "sort"
+
+// Slice returns a Comparator comparing two slices element-wise using c,
+// with a shorter slice that is a prefix of a longer one considered less.
+func Slice[T any](c Comparator[T]) Comparator[[]T] {
+	return func(a, b []T) int {
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if res := c(a[i], b[i]); res != 0 {
+				return res
+			}
+		}
+		return cmpInt(len(a), len(b))
+	}
+}
+
+// Map returns a Comparator comparing two maps by walking their keys in
+// sorted order, comparing the keys at each position and then the
+// corresponding values with cv. Length only breaks a tie: if every
+// position of the shorter key list compares equal, the map with fewer
+// keys is considered less.
+func Map[K Ordered, V any](cv Comparator[V]) Comparator[map[K]V] {
+	ck := Natural[K]()
+	return func(a, b map[K]V) int {
+		ka, kb := sortedKeys(a, ck), sortedKeys(b, ck)
+		for i := 0; i < len(ka) && i < len(kb); i++ {
+			if res := ck(ka[i], kb[i]); res != 0 {
+				return res
+			}
+			if res := cv(a[ka[i]], b[kb[i]]); res != 0 {
+				return res
+			}
+		}
+		return cmpInt(len(ka), len(kb))
+	}
+}
+
+func sortedKeys[K Ordered, V any](m map[K]V, ck Comparator[K]) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return ck.Less(keys[i], keys[j]) })
+	return keys
+}
+
+// Fields folds multiple By-style projections into a single Comparator,
+// equivalent to By(extractors[0]).Then(By(extractors[1])).Then(...).
+func Fields[T any, R Ordered](extractors ...func(T) R) Comparator[T] {
+	return func(a, b T) int {
+		for _, f := range extractors {
+			if res := cmpOrdered(f(a), f(b)); res != 0 {
+				return res
+			}
+		}
+		return 0
+	}
+}
+
+// Pair2 is a 2-tuple of values, compared lexicographically by [Tuple2].
+type Pair2[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Tuple2 returns a Comparator over Pair2 that compares First with c1, then,
+// if equal, compares Second with c2.
+func Tuple2[A, B any](c1 Comparator[A], c2 Comparator[B]) Comparator[Pair2[A, B]] {
+	return func(a, b Pair2[A, B]) int {
+		if res := c1(a.First, b.First); res != 0 {
+			return res
+		}
+		return c2(a.Second, b.Second)
+	}
+}
+
+// Pair3 is a 3-tuple of values, compared lexicographically by [Tuple3].
+type Pair3[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Tuple3 returns a Comparator over Pair3 that compares First, Second, then
+// Third in order, short-circuiting on the first non-zero comparison.
+func Tuple3[A, B, C any](c1 Comparator[A], c2 Comparator[B], c3 Comparator[C]) Comparator[Pair3[A, B, C]] {
+	return func(a, b Pair3[A, B, C]) int {
+		if res := c1(a.First, b.First); res != 0 {
+			return res
+		}
+		if res := c2(a.Second, b.Second); res != 0 {
+			return res
+		}
+		return c3(a.Third, b.Third)
+	}
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func cmpOrdered[R Ordered](a, b R) int {
+	return Natural[R]()(a, b)
+}
@@ -0,0 +1,101 @@
+package cmp
+
+import "testing"
+
+func TestSlice(t *testing.T) {
+	c := Slice(Natural[int]())
+	tests := []struct {
+		name string
+		a, b []int
+		want int
+	}{
+		{"equal", []int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{"less by element", []int{1, 2, 3}, []int{1, 3, 3}, -1},
+		{"greater by element", []int{1, 3, 3}, []int{1, 2, 3}, 1},
+		{"shorter prefix is less", []int{1, 2}, []int{1, 2, 3}, -1},
+		{"longer with prefix is greater", []int{1, 2, 3}, []int{1, 2}, 1},
+		{"both empty", []int{}, []int{}, 0},
+		{"empty is less", []int{}, []int{1}, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c(tt.a, tt.b); got != tt.want {
+				t.Errorf("Slice(Natural[int]())(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	c := Map[string](Natural[int]())
+	tests := []struct {
+		name string
+		a, b map[string]int
+		want int
+	}{
+		{"equal", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 2}, 0},
+		{"differs by key", map[string]int{"a": 1}, map[string]int{"b": 1}, -1},
+		{"differs by value at shared key", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 3}, -1},
+		{"shorter map is less", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, -1},
+		{"both empty", map[string]int{}, map[string]int{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c(tt.a, tt.b); got != tt.want {
+				t.Errorf("Map(...)(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+type person struct {
+	last, first string
+	age         int
+}
+
+func TestFields(t *testing.T) {
+	c := Fields(
+		func(p person) string { return p.last },
+		func(p person) string { return p.first },
+	)
+	tests := []struct {
+		name string
+		a, b person
+		want int
+	}{
+		{"equal", person{"Doe", "Jane", 30}, person{"Doe", "Jane", 99}, 0},
+		{"differs by first field", person{"Adams", "Zoe", 0}, person{"Doe", "Ann", 0}, -1},
+		{"differs by second field on tie", person{"Doe", "Ann", 0}, person{"Doe", "Zoe", 0}, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c(tt.a, tt.b); got != tt.want {
+				t.Errorf("Fields(...)(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTuple2(t *testing.T) {
+	c := Tuple2(Natural[int](), Natural[string]())
+	a := Pair2[int, string]{First: 1, Second: "b"}
+	b := Pair2[int, string]{First: 1, Second: "a"}
+	if got := c(a, b); got != 1 {
+		t.Errorf("Tuple2(...)(%v, %v) = %d, want 1", a, b, got)
+	}
+	if got := c(b, a); got != -1 {
+		t.Errorf("Tuple2(...)(%v, %v) = %d, want -1", b, a, got)
+	}
+}
+
+func TestTuple3(t *testing.T) {
+	c := Tuple3(Natural[int](), Natural[int](), Natural[int]())
+	a := Pair3[int, int, int]{1, 2, 3}
+	b := Pair3[int, int, int]{1, 2, 4}
+	if got := c(a, b); got != -1 {
+		t.Errorf("Tuple3(...)(%v, %v) = %d, want -1", a, b, got)
+	}
+	if got := c(a, a); got != 0 {
+		t.Errorf("Tuple3(...)(%v, %v) = %d, want 0", a, a, got)
+	}
+}
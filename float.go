@@ -0,0 +1,68 @@
+package cmp
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// FloatComparator is a Comparator specialized for floating-point types,
+// offering Max/Min variants that propagate NaN instead of treating it
+// as less than any non-NaN value.
+type FloatComparator[T Float] Comparator[T]
+
+// NaturalPropagateNaN returns a FloatComparator wrapping Natural[T], whose
+// MaxPropagateNaN/MinPropagateNaN methods return NaN if any argument is NaN,
+// matching the built-in max/min functions.
+//
+// Natural itself intentionally diverges from built-in max here: it
+// orders NaN as less than any non-NaN value, so Natural[float64]().Max
+// silently discards a NaN argument instead of propagating it.
+func NaturalPropagateNaN[T Float]() FloatComparator[T] {
+	return FloatComparator[T](Natural[T]())
+}
+
+// PropagateNaN wraps c in a FloatComparator whose MaxPropagateNaN/MinPropagateNaN
+// propagate NaN instead of ordering it as less than any non-NaN value.
+func PropagateNaN[T Float](c Comparator[T]) FloatComparator[T] {
+	return FloatComparator[T](c)
+}
+
+func isNaN[T Float](v T) bool {
+	return v != v
+}
+
+// MaxPropagateNaN returns the maximum of value and values, returning NaN if
+// any of them is NaN.
+func (c FloatComparator[T]) MaxPropagateNaN(value T, values ...T) T {
+	if isNaN(value) {
+		return value
+	}
+	max := value
+	for _, v := range values {
+		if isNaN(v) {
+			return v
+		}
+		if c(v, max) > 0 {
+			max = v
+		}
+	}
+	return max
+}
+
+// MinPropagateNaN returns the minimum of value and values, returning NaN if
+// any of them is NaN.
+func (c FloatComparator[T]) MinPropagateNaN(value T, values ...T) T {
+	if isNaN(value) {
+		return value
+	}
+	min := value
+	for _, v := range values {
+		if isNaN(v) {
+			return v
+		}
+		if c(v, min) < 0 {
+			min = v
+		}
+	}
+	return min
+}
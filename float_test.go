@@ -0,0 +1,34 @@
+package cmp
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatComparatorPropagateNaN(t *testing.T) {
+	c := NaturalPropagateNaN[float64]()
+
+	if got := c.MaxPropagateNaN(1.0, 2.0, 3.0); got != 3.0 {
+		t.Errorf("MaxPropagateNaN(1, 2, 3) = %v, want 3", got)
+	}
+	if got := c.MinPropagateNaN(1.0, 2.0, 3.0); got != 1.0 {
+		t.Errorf("MinPropagateNaN(1, 2, 3) = %v, want 1", got)
+	}
+	if got := c.MaxPropagateNaN(1.0, math.NaN(), 3.0); !math.IsNaN(got) {
+		t.Errorf("MaxPropagateNaN(1, NaN, 3) = %v, want NaN", got)
+	}
+	if got := c.MinPropagateNaN(1.0, math.NaN(), 3.0); !math.IsNaN(got) {
+		t.Errorf("MinPropagateNaN(1, NaN, 3) = %v, want NaN", got)
+	}
+}
+
+func TestFloatComparatorPropagateNaNRespectsOrdering(t *testing.T) {
+	c := PropagateNaN(Natural[float64]().Reversed())
+
+	if got := c.MaxPropagateNaN(1.0, 2.0, 3.0); got != 1.0 {
+		t.Errorf("PropagateNaN(Reversed).MaxPropagateNaN(1, 2, 3) = %v, want 1", got)
+	}
+	if got := c.MinPropagateNaN(1.0, 2.0, 3.0); got != 3.0 {
+		t.Errorf("PropagateNaN(Reversed).MinPropagateNaN(1, 2, 3) = %v, want 3", got)
+	}
+}
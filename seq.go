@@ -0,0 +1,67 @@
+package cmp
+
+import "iter"
+
+// MaxSeq returns the maximum value produced by seq according to c, and
+// false if seq produces no values.
+func (c Comparator[T]) MaxSeq(seq iter.Seq[T]) (max T, ok bool) {
+	for v := range seq {
+		if !ok || c(v, max) > 0 {
+			max, ok = v, true
+		}
+	}
+	return max, ok
+}
+
+// MinSeq returns the minimum value produced by seq according to c, and
+// false if seq produces no values.
+func (c Comparator[T]) MinSeq(seq iter.Seq[T]) (min T, ok bool) {
+	for v := range seq {
+		if !ok || c(v, min) < 0 {
+			min, ok = v, true
+		}
+	}
+	return min, ok
+}
+
+// MinMaxSeq returns both the minimum and maximum value produced by seq
+// according to c, and false if seq produces no values. It processes
+// elements in pairs, comparing each pair against each other before
+// comparing the smaller to the running min and the larger to the running
+// max, for about 1.5n comparisons instead of the 2n a separate MinSeq and
+// MaxSeq would cost.
+func (c Comparator[T]) MinMaxSeq(seq iter.Seq[T]) (min, max T, ok bool) {
+	next, stop := iter.Pull(seq)
+	defer stop()
+
+	first, hasFirst := next()
+	if !hasFirst {
+		return min, max, false
+	}
+	min, max, ok = first, first, true
+
+	for {
+		a, hasA := next()
+		if !hasA {
+			return min, max, true
+		}
+		b, hasB := next()
+		if !hasB {
+			if c(a, min) < 0 {
+				min = a
+			} else if c(a, max) > 0 {
+				max = a
+			}
+			return min, max, true
+		}
+		if c(a, b) > 0 {
+			a, b = b, a
+		}
+		if c(a, min) < 0 {
+			min = a
+		}
+		if c(b, max) > 0 {
+			max = b
+		}
+	}
+}
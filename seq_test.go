@@ -0,0 +1,62 @@
+package cmp
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMinEmptyValues(t *testing.T) {
+	c := Natural[int]()
+	if got := c.Min(5); got != 5 {
+		t.Errorf("Min(5) = %d, want 5", got)
+	}
+}
+
+func TestMaxSeq(t *testing.T) {
+	c := Natural[int]()
+	if got, ok := c.MaxSeq(slices.Values([]int{3, 1, 4, 1, 5})); !ok || got != 5 {
+		t.Errorf("MaxSeq(...) = (%d, %v), want (5, true)", got, ok)
+	}
+	if _, ok := c.MaxSeq(slices.Values([]int{})); ok {
+		t.Errorf("MaxSeq(empty) ok = true, want false")
+	}
+}
+
+func TestMinSeq(t *testing.T) {
+	c := Natural[int]()
+	if got, ok := c.MinSeq(slices.Values([]int{3, 1, 4, 1, 5})); !ok || got != 1 {
+		t.Errorf("MinSeq(...) = (%d, %v), want (1, true)", got, ok)
+	}
+	if _, ok := c.MinSeq(slices.Values([]int{})); ok {
+		t.Errorf("MinSeq(empty) ok = true, want false")
+	}
+}
+
+func TestMinMaxSeq(t *testing.T) {
+	c := Natural[int]()
+	tests := []struct {
+		name     string
+		in       []int
+		min, max int
+		wantOK   bool
+	}{
+		{"empty", []int{}, 0, 0, false},
+		{"single", []int{7}, 7, 7, true},
+		{"even count", []int{3, 1, 4, 1, 5, 9}, 1, 9, true},
+		{"odd count", []int{3, 1, 4, 1, 5}, 1, 5, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			min, max, ok := c.MinMaxSeq(slices.Values(tt.in))
+			if ok != tt.wantOK {
+				t.Fatalf("MinMaxSeq(%v) ok = %v, want %v", tt.in, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if min != tt.min || max != tt.max {
+				t.Errorf("MinMaxSeq(%v) = (%d, %d), want (%d, %d)", tt.in, min, max, tt.min, tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package cmp
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// Sort sorts s in place using c as the ordering. It is not guaranteed to
+// be stable; use SortStable if equal elements must keep their relative order.
+func (c Comparator[T]) Sort(s []T) {
+	sort.Slice(s, func(i, j int) bool { return c.Less(s[i], s[j]) })
+}
+
+// SortStable sorts s in place using c as the ordering, keeping equal
+// elements in their original relative order.
+func (c Comparator[T]) SortStable(s []T) {
+	sort.SliceStable(s, func(i, j int) bool { return c.Less(s[i], s[j]) })
+}
+
+// IsSorted reports whether s is sorted according to c.
+func (c Comparator[T]) IsSorted(s []T) bool {
+	for i := 1; i < len(s); i++ {
+		if c.Less(s[i], s[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// BinarySearch searches for target in a sorted slice s ordered by c,
+// returning the index where target is found, or where it would be
+// inserted, and whether it was found. s must be sorted according to c.
+func (c Comparator[T]) BinarySearch(s []T, target T) (int, bool) {
+	i := sort.Search(len(s), func(i int) bool { return c(s[i], target) >= 0 })
+	return i, i < len(s) && c(s[i], target) == 0
+}
+
+// Heap implements container/heap.Interface over a slice of T, ordered by
+// c, so that Dequeue (or heap.Pop) always returns the minimum element
+// according to c.
+type Heap[T any] struct {
+	s []T
+	c Comparator[T]
+}
+
+// NewHeap returns an empty Heap ordered by c.
+func NewHeap[T any](c Comparator[T]) *Heap[T] {
+	return &Heap[T]{c: c}
+}
+
+func (h *Heap[T]) Len() int           { return len(h.s) }
+func (h *Heap[T]) Less(i, j int) bool { return h.c.Less(h.s[i], h.s[j]) }
+func (h *Heap[T]) Swap(i, j int)      { h.s[i], h.s[j] = h.s[j], h.s[i] }
+
+// Push and Pop implement heap.Interface for use by the container/heap
+// package; like container/heap's own examples, they do not maintain the
+// heap invariant on their own. Calling Push directly breaks the heap
+// invariant, and calling Pop directly returns the last element rather
+// than the minimum. Use [Heap.Enqueue], [Heap.Dequeue], [Heap.PushPop], or the
+// package-level heap.Push/heap.Pop functions instead.
+func (h *Heap[T]) Push(x any) {
+	h.s = append(h.s, x.(T))
+}
+
+func (h *Heap[T]) Pop() any {
+	n := len(h.s)
+	v := h.s[n-1]
+	h.s = h.s[:n-1]
+	return v
+}
+
+// Enqueue adds v to the heap, maintaining the heap invariant.
+func (h *Heap[T]) Enqueue(v T) {
+	heap.Push(h, v)
+}
+
+// Dequeue removes and returns the minimum element, maintaining the heap
+// invariant. Dequeue panics if the heap is empty.
+func (h *Heap[T]) Dequeue() T {
+	return heap.Pop(h).(T)
+}
+
+// PushPop pushes v onto the heap, then pops and returns the new minimum.
+// It is more efficient than a separate Enqueue followed by Dequeue.
+func (h *Heap[T]) PushPop(v T) T {
+	if len(h.s) > 0 && h.c.Less(h.s[0], v) {
+		v, h.s[0] = h.s[0], v
+		heap.Fix(h, 0)
+	}
+	return v
+}
+
+// Peek returns the minimum element without removing it. Peek panics if
+// the heap is empty.
+func (h *Heap[T]) Peek() T {
+	return h.s[0]
+}
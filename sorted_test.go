@@ -0,0 +1,84 @@
+package cmp
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	s := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	Natural[int]().Sort(s)
+	if !slices.IsSorted(s) {
+		t.Errorf("Sort left slice unsorted: %v", s)
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	type kv struct {
+		key, order int
+	}
+	s := []kv{{1, 0}, {2, 1}, {1, 2}, {2, 3}}
+	By(func(e kv) int { return e.key }).SortStable(s)
+	want := []kv{{1, 0}, {1, 2}, {2, 1}, {2, 3}}
+	if !slices.Equal(s, want) {
+		t.Errorf("SortStable result = %v, want %v", s, want)
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	c := Natural[int]()
+	if !c.IsSorted([]int{1, 2, 2, 3}) {
+		t.Error("IsSorted([1 2 2 3]) = false, want true")
+	}
+	if c.IsSorted([]int{1, 3, 2}) {
+		t.Error("IsSorted([1 3 2]) = true, want false")
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	c := Natural[int]()
+	s := []int{1, 3, 5, 7, 9}
+	if i, ok := c.BinarySearch(s, 5); !ok || i != 2 {
+		t.Errorf("BinarySearch(s, 5) = (%d, %v), want (2, true)", i, ok)
+	}
+	if i, ok := c.BinarySearch(s, 4); ok || i != 2 {
+		t.Errorf("BinarySearch(s, 4) = (%d, %v), want (2, false)", i, ok)
+	}
+	if i, ok := c.BinarySearch(s, 10); ok || i != 5 {
+		t.Errorf("BinarySearch(s, 10) = (%d, %v), want (5, false)", i, ok)
+	}
+}
+
+func TestHeap(t *testing.T) {
+	h := NewHeap(Natural[int]())
+	for _, v := range []int{5, 1, 3, 2, 4} {
+		h.Enqueue(v)
+	}
+
+	if got := h.Peek(); got != 1 {
+		t.Errorf("Peek() = %d, want 1", got)
+	}
+
+	var got []int
+	for h.Len() > 0 {
+		got = append(got, h.Dequeue())
+	}
+	want := []int{1, 2, 3, 4, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("Dequeue order = %v, want %v", got, want)
+	}
+}
+
+func TestHeapPushPop(t *testing.T) {
+	h := NewHeap(Natural[int]())
+	h.Enqueue(5)
+	h.Enqueue(1)
+	h.Enqueue(3)
+
+	if got := h.PushPop(0); got != 0 {
+		t.Errorf("PushPop(0) = %d, want 0", got)
+	}
+	if got := h.Peek(); got != 1 {
+		t.Errorf("Peek() after PushPop(0) = %d, want 1", got)
+	}
+}